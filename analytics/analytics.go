@@ -0,0 +1,207 @@
+// Package analytics computes derived statistics on top of the raw series
+// returned by osrswiki.Client.Timeseries, so callers don't have to hand-roll
+// moving averages and margin math themselves.
+package analytics
+
+import (
+	"math"
+
+	"github.com/joeychilson/osrswiki"
+)
+
+// midpoint returns the average of a point's high and low prices, falling
+// back to whichever side is non-zero if only one is populated. It returns
+// 0 when neither side traded in the interval.
+func midpoint(d osrswiki.TimeseriesData) float64 {
+	switch {
+	case d.AvgHighPrice > 0 && d.AvgLowPrice > 0:
+		return float64(d.AvgHighPrice+d.AvgLowPrice) / 2
+	case d.AvgHighPrice > 0:
+		return float64(d.AvgHighPrice)
+	default:
+		return float64(d.AvgLowPrice)
+	}
+}
+
+// filledPrices returns the series' midpoint prices with no-trade points
+// (midpoint == 0, a common occurrence for illiquid items) carried forward
+// from the most recently traded price, so a trading gap doesn't get
+// treated as a genuine price of 0. Points before the first trade are left
+// as math.NaN().
+func filledPrices(data []osrswiki.TimeseriesData) []float64 {
+	prices := make([]float64, len(data))
+	last := math.NaN()
+	for i, d := range data {
+		if p := midpoint(d); p > 0 {
+			last = p
+		}
+		prices[i] = last
+	}
+	return prices
+}
+
+// firstValidIndex returns the index of the first non-NaN value in prices,
+// or -1 if every value is NaN.
+func firstValidIndex(prices []float64) int {
+	for i, p := range prices {
+		if !math.IsNaN(p) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SMA computes the simple moving average of the series' midpoint price
+// over a window of period points, carrying forward the last traded price
+// through no-trade gaps. The returned slice has the same length as data;
+// points before the window has filled are math.NaN().
+func SMA(data []osrswiki.TimeseriesData, period int) []float64 {
+	result := make([]float64, len(data))
+	for i := range result {
+		result[i] = math.NaN()
+	}
+	if period <= 0 {
+		return result
+	}
+
+	prices := filledPrices(data)
+	start := firstValidIndex(prices)
+	if start < 0 {
+		return result
+	}
+
+	var sum float64
+	count := 0
+	for i := start; i < len(prices); i++ {
+		sum += prices[i]
+		count++
+		if count > period {
+			sum -= prices[i-period]
+			count--
+		}
+		if count == period {
+			result[i] = sum / float64(period)
+		}
+	}
+	return result
+}
+
+// EMA computes the exponential moving average of the series' midpoint
+// price with the standard smoothing factor 2/(period+1), seeded by the
+// SMA of the first period traded points and carrying forward the last
+// traded price through no-trade gaps. The returned slice has the same
+// length as data; points before the window has filled are math.NaN().
+func EMA(data []osrswiki.TimeseriesData, period int) []float64 {
+	result := make([]float64, len(data))
+	for i := range result {
+		result[i] = math.NaN()
+	}
+	if period <= 0 {
+		return result
+	}
+
+	prices := filledPrices(data)
+	start := firstValidIndex(prices)
+	if start < 0 || len(prices)-start < period {
+		return result
+	}
+
+	var seed float64
+	for i := start; i < start+period; i++ {
+		seed += prices[i]
+	}
+	seed /= float64(period)
+	result[start+period-1] = seed
+
+	alpha := 2 / (float64(period) + 1)
+
+	prev := seed
+	for i := start + period; i < len(prices); i++ {
+		prev = prices[i]*alpha + prev*(1-alpha)
+		result[i] = prev
+	}
+	return result
+}
+
+// Volatility computes rolling volatility as the standard deviation of log
+// returns over a window of period points. The returned slice has the same
+// length as data; points before the window has filled are math.NaN().
+func Volatility(data []osrswiki.TimeseriesData, period int) []float64 {
+	result := make([]float64, len(data))
+	for i := range result {
+		result[i] = math.NaN()
+	}
+	if period <= 0 || len(data) < period+1 {
+		return result
+	}
+
+	returns := make([]float64, len(data))
+	returns[0] = math.NaN()
+	for i := 1; i < len(data); i++ {
+		prev, cur := midpoint(data[i-1]), midpoint(data[i])
+		if prev <= 0 || cur <= 0 {
+			returns[i] = math.NaN()
+			continue
+		}
+		returns[i] = math.Log(cur / prev)
+	}
+
+	for i := period; i < len(data); i++ {
+		window := returns[i-period+1 : i+1]
+
+		var mean float64
+		var n int
+		for _, r := range window {
+			if math.IsNaN(r) {
+				continue
+			}
+			mean += r
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		mean /= float64(n)
+
+		var variance float64
+		for _, r := range window {
+			if math.IsNaN(r) {
+				continue
+			}
+			variance += (r - mean) * (r - mean)
+		}
+		variance /= float64(n)
+
+		result[i] = math.Sqrt(variance)
+	}
+	return result
+}
+
+// VWAP computes the volume-weighted average price across the entire
+// series, using each point's combined high/low trade volume as its weight.
+func VWAP(data []osrswiki.TimeseriesData) float64 {
+	var weightedSum, totalVolume float64
+	for _, d := range data {
+		volume := float64(d.HighPriceVolume + d.LowPriceVolume)
+		weightedSum += midpoint(d) * volume
+		totalVolume += volume
+	}
+	if totalVolume == 0 {
+		return 0
+	}
+	return weightedSum / totalVolume
+}
+
+// geTaxRate is the share of the sell price kept after the Grand Exchange's
+// flat 1% sell tax.
+const geTaxRate = 0.99
+
+// Margin computes the GE-tax-aware profit of flipping an item: the
+// per-item margin is the sell price after tax minus the buy price, and the
+// total is that margin multiplied by the item's buy limit (from
+// osrswiki.ItemMapping.Limit), i.e. the profit on a full buy limit.
+func Margin(sellPrice, buyPrice int64, limit int) (perItem, total float64) {
+	perItem = float64(sellPrice)*geTaxRate - float64(buyPrice)
+	total = perItem * float64(limit)
+	return perItem, total
+}
@@ -0,0 +1,113 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/joeychilson/osrswiki"
+)
+
+func series(prices ...int64) []osrswiki.TimeseriesData {
+	data := make([]osrswiki.TimeseriesData, len(prices))
+	for i, p := range prices {
+		data[i] = osrswiki.TimeseriesData{AvgHighPrice: p, AvgLowPrice: p}
+	}
+	return data
+}
+
+func TestSMA(t *testing.T) {
+	data := series(10, 20, 30, 40, 50)
+	got := SMA(data, 3)
+
+	for i := 0; i < 2; i++ {
+		if !math.IsNaN(got[i]) {
+			t.Errorf("SMA[%d] = %v, want NaN", i, got[i])
+		}
+	}
+
+	want := []float64{20, 30, 40}
+	for i, w := range want {
+		if got[i+2] != w {
+			t.Errorf("SMA[%d] = %v, want %v", i+2, got[i+2], w)
+		}
+	}
+}
+
+func TestSMACarriesForwardNoTradePoints(t *testing.T) {
+	// A no-trade gap (zero price) should carry forward the last traded
+	// price instead of dragging the average toward 0.
+	data := series(100, 100, 0, 0, 100)
+	got := SMA(data, 3)
+
+	if got[2] != 100 || got[3] != 100 || got[4] != 100 {
+		t.Errorf("SMA with no-trade gap = %v, want all 100", got)
+	}
+}
+
+func TestEMASeedsFromSMAAndCarriesForward(t *testing.T) {
+	data := series(100, 100, 100, 0, 100)
+	got := EMA(data, 3)
+
+	if got[2] != 100 {
+		t.Errorf("EMA seed = %v, want 100", got[2])
+	}
+	if got[3] != 100 || got[4] != 100 {
+		t.Errorf("EMA with no-trade gap = %v, want all 100", got)
+	}
+}
+
+func TestEMAInsufficientDataIsAllNaN(t *testing.T) {
+	data := series(100, 100)
+	got := EMA(data, 5)
+
+	for i, v := range got {
+		if !math.IsNaN(v) {
+			t.Errorf("EMA[%d] = %v, want NaN", i, v)
+		}
+	}
+}
+
+func TestVolatilityFlatSeriesIsZero(t *testing.T) {
+	data := series(100, 100, 100, 100, 100)
+	got := Volatility(data, 3)
+
+	for i := 3; i < len(got); i++ {
+		if got[i] != 0 {
+			t.Errorf("Volatility[%d] = %v, want 0 for a flat series", i, got[i])
+		}
+	}
+}
+
+func TestVolatilityIgnoresNoTradePoints(t *testing.T) {
+	data := series(100, 0, 100, 100, 100)
+	got := Volatility(data, 3)
+
+	if math.IsInf(got[4], 0) || math.IsNaN(got[4]) {
+		t.Errorf("Volatility[4] = %v, want a finite value", got[4])
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	data := []osrswiki.TimeseriesData{
+		{AvgHighPrice: 100, AvgLowPrice: 100, HighPriceVolume: 1, LowPriceVolume: 1},
+		{AvgHighPrice: 200, AvgLowPrice: 200, HighPriceVolume: 3, LowPriceVolume: 3},
+	}
+
+	got := VWAP(data)
+	want := (100.0*2 + 200.0*6) / 8
+	if got != want {
+		t.Errorf("VWAP() = %v, want %v", got, want)
+	}
+}
+
+func TestMargin(t *testing.T) {
+	perItem, total := Margin(1000, 900, 10)
+
+	wantPerItem := 1000*0.99 - 900
+	if perItem != wantPerItem {
+		t.Errorf("Margin() perItem = %v, want %v", perItem, wantPerItem)
+	}
+	if want := wantPerItem * 10; total != want {
+		t.Errorf("Margin() total = %v, want %v", total, want)
+	}
+}
@@ -0,0 +1,107 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/joeychilson/osrswiki"
+)
+
+// Mover is one item's percent price change between two consecutive
+// PriceData intervals, together with its combined trade volume in the
+// current interval, as returned by TopMovers.
+type Mover struct {
+	ItemID        int32
+	PercentChange float64
+	Volume        int64
+}
+
+// intervalDuration maps a TimeInterval to its wall-clock length. Only the
+// intervals accepted by osrswiki's PriceData endpoint are supported.
+func intervalDuration(interval osrswiki.TimeInterval) (time.Duration, error) {
+	switch interval {
+	case osrswiki.FiveMinutes:
+		return 5 * time.Minute, nil
+	case osrswiki.OneHour:
+		return time.Hour, nil
+	default:
+		return 0, fmt.Errorf("unsupported interval: %s", interval)
+	}
+}
+
+// TopMovers fetches PriceData for the current interval and the one
+// immediately preceding it, then ranks items by absolute percent price
+// change, breaking ties by combined trade volume. It returns at most topN
+// movers.
+func TopMovers(ctx context.Context, client *osrswiki.Client, world osrswiki.World, interval osrswiki.TimeInterval, topN int) ([]Mover, error) {
+	duration, err := intervalDuration(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := client.NewPriceDataService().World(world).Interval(interval).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching current interval: %w", err)
+	}
+
+	// PriceData's response carries no bucket timestamp, so the best we can
+	// do is align to the same wall-clock grid the upstream buckets on
+	// (e.g. :00/:05/:10 for 5m) before stepping back one full interval.
+	// Subtracting duration from a raw time.Now() can land back inside the
+	// bucket "current" already covers if called shortly after a rollover.
+	currentBucket := time.Now().Truncate(duration)
+	previous, err := client.NewPriceDataService().World(world).Interval(interval).Timestamp(currentBucket.Add(-duration).Unix()).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching previous interval: %w", err)
+	}
+
+	movers := make([]Mover, 0, len(current))
+	for id, cur := range current {
+		prev, ok := previous[id]
+		if !ok {
+			continue
+		}
+
+		curPrice := midpointPriceData(cur)
+		prevPrice := midpointPriceData(prev)
+		if prevPrice <= 0 {
+			continue
+		}
+
+		movers = append(movers, Mover{
+			ItemID:        id,
+			PercentChange: (curPrice - prevPrice) / prevPrice * 100,
+			Volume:        cur.HighPriceVolume + cur.LowPriceVolume,
+		})
+	}
+
+	sort.Slice(movers, func(i, j int) bool {
+		absI, absJ := math.Abs(movers[i].PercentChange), math.Abs(movers[j].PercentChange)
+		if absI != absJ {
+			return absI > absJ
+		}
+		return movers[i].Volume > movers[j].Volume
+	})
+
+	if topN < 0 {
+		topN = 0
+	}
+	if topN < len(movers) {
+		movers = movers[:topN]
+	}
+	return movers, nil
+}
+
+func midpointPriceData(d osrswiki.PriceData) float64 {
+	switch {
+	case d.AvgHighPrice > 0 && d.AvgLowPrice > 0:
+		return float64(d.AvgHighPrice+d.AvgLowPrice) / 2
+	case d.AvgHighPrice > 0:
+		return float64(d.AvgHighPrice)
+	default:
+		return float64(d.AvgLowPrice)
+	}
+}
@@ -0,0 +1,113 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/joeychilson/osrswiki"
+)
+
+// priceDataServer returns an httptest.Server that serves distinct PriceData
+// bodies for the "current" interval (no timestamp query param) and the
+// "previous" one (timestamp set), mirroring how TopMovers issues its two
+// chained PriceDataService.Do calls.
+func priceDataServer(t *testing.T, current, previous string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("timestamp") != "" {
+			w.Write([]byte(previous))
+			return
+		}
+		w.Write([]byte(current))
+	}))
+}
+
+func TestTopMoversRanksByPercentChangeThenVolume(t *testing.T) {
+	current := `{"data":{
+		"1":{"avgHighPrice":110,"avgLowPrice":110,"highPriceVolume":10,"lowPriceVolume":10},
+		"2":{"avgHighPrice":105,"avgLowPrice":105,"highPriceVolume":10,"lowPriceVolume":10},
+		"3":{"avgHighPrice":120,"avgLowPrice":120,"highPriceVolume":1000,"lowPriceVolume":500},
+		"4":{"avgHighPrice":120,"avgLowPrice":120,"highPriceVolume":100,"lowPriceVolume":100}
+	}}`
+	previous := `{"data":{
+		"1":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10},
+		"2":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10},
+		"3":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10},
+		"4":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10}
+	}}`
+
+	server := priceDataServer(t, current, previous)
+	defer server.Close()
+
+	client := osrswiki.NewClient("test-agent", osrswiki.WithBaseURL(server.URL))
+
+	movers, err := TopMovers(context.Background(), client, osrswiki.WorldRegular, osrswiki.FiveMinutes, 2)
+	if err != nil {
+		t.Fatalf("TopMovers() error = %v, want nil", err)
+	}
+
+	if len(movers) != 2 {
+		t.Fatalf("TopMovers() returned %d movers, want 2", len(movers))
+	}
+
+	// Items 3 and 4 both moved +20%, tied; 3 has more volume so it sorts first.
+	if movers[0].ItemID != 3 || movers[1].ItemID != 4 {
+		t.Errorf("TopMovers() order = [%d, %d], want [3, 4]", movers[0].ItemID, movers[1].ItemID)
+	}
+	if got, want := movers[0].PercentChange, 20.0; got != want {
+		t.Errorf("movers[0].PercentChange = %v, want %v", got, want)
+	}
+}
+
+func TestTopMoversSkipsItemsMissingFromEitherInterval(t *testing.T) {
+	current := `{"data":{
+		"1":{"avgHighPrice":110,"avgLowPrice":110,"highPriceVolume":10,"lowPriceVolume":10},
+		"2":{"avgHighPrice":200,"avgLowPrice":200,"highPriceVolume":10,"lowPriceVolume":10}
+	}}`
+	previous := `{"data":{
+		"1":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10}
+	}}`
+
+	server := priceDataServer(t, current, previous)
+	defer server.Close()
+
+	client := osrswiki.NewClient("test-agent", osrswiki.WithBaseURL(server.URL))
+
+	movers, err := TopMovers(context.Background(), client, osrswiki.WorldRegular, osrswiki.FiveMinutes, 10)
+	if err != nil {
+		t.Fatalf("TopMovers() error = %v, want nil", err)
+	}
+
+	if len(movers) != 1 || movers[0].ItemID != 1 {
+		t.Fatalf("TopMovers() = %+v, want only item 1 (item 2 has no previous interval data)", movers)
+	}
+}
+
+func TestTopMoversNegativeTopNReturnsEmpty(t *testing.T) {
+	current := `{"data":{"1":{"avgHighPrice":110,"avgLowPrice":110,"highPriceVolume":10,"lowPriceVolume":10}}}`
+	previous := `{"data":{"1":{"avgHighPrice":100,"avgLowPrice":100,"highPriceVolume":10,"lowPriceVolume":10}}}`
+
+	server := priceDataServer(t, current, previous)
+	defer server.Close()
+
+	client := osrswiki.NewClient("test-agent", osrswiki.WithBaseURL(server.URL))
+
+	movers, err := TopMovers(context.Background(), client, osrswiki.WorldRegular, osrswiki.FiveMinutes, -1)
+	if err != nil {
+		t.Fatalf("TopMovers() error = %v, want nil", err)
+	}
+	if len(movers) != 0 {
+		t.Errorf("TopMovers(topN=-1) = %+v, want empty", movers)
+	}
+}
+
+func TestTopMoversUnsupportedIntervalErrors(t *testing.T) {
+	client := osrswiki.NewClient("test-agent")
+
+	if _, err := TopMovers(context.Background(), client, osrswiki.WorldRegular, osrswiki.SixHours, 5); err == nil {
+		t.Error("TopMovers() with 6h interval error = nil, want error")
+	}
+}
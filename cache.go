@@ -0,0 +1,149 @@
+package osrswiki
+
+import (
+	"container/list"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies keyed by request URL. The default
+// implementation is an in-memory LRU, but callers can plug in their own
+// (e.g. backed by Redis) via WithCache.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, val []byte, ttl time.Duration)
+}
+
+// defaultCacheCapacity bounds the number of entries kept by the default
+// in-memory cache.
+const defaultCacheCapacity = 256
+
+// WithCache overrides the Client's default in-memory LRU cache with a
+// caller-provided implementation.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// cacheKey builds a canonical, deterministic key for a GET request so that
+// identical requests map to the same cache entry regardless of map
+// iteration order.
+func cacheKey(rawURL string, query map[string]string) string {
+	if len(query) == 0 {
+		return rawURL
+	}
+
+	values := url.Values{}
+	for k, v := range query {
+		values.Set(k, v)
+	}
+	return rawURL + "?" + values.Encode()
+}
+
+// cacheTTL picks the TTL to store a response under, preferring the
+// upstream's own Cache-Control max-age (adjusted for Age) over the
+// endpoint's default when present.
+func cacheTTL(header http.Header, fallback time.Duration) time.Duration {
+	maxAge, ok := maxAgeFromCacheControl(header.Get("Cache-Control"))
+	if !ok {
+		return fallback
+	}
+
+	if age, err := strconv.Atoi(header.Get("Age")); err == nil {
+		maxAge -= age
+	}
+	if maxAge <= 0 {
+		return 0
+	}
+	return time.Duration(maxAge) * time.Second
+}
+
+func maxAgeFromCacheControl(header string) (int, bool) {
+	for _, directive := range strings.Split(header, ",") {
+		directive = strings.TrimSpace(directive)
+		name, value, found := strings.Cut(directive, "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "max-age") {
+			continue
+		}
+		if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+			return seconds, true
+		}
+	}
+	return 0, false
+}
+
+type lruEntry struct {
+	key     string
+	val     []byte
+	expires time.Time
+}
+
+// lruCache is the default Cache implementation: an in-memory, size-bounded
+// LRU with per-entry expiry.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (c *lruCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return entry.val, true
+}
+
+func (c *lruCache) Set(key string, val []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).val = val
+		el.Value.(*lruEntry).expires = time.Now().Add(ttl)
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, val: val, expires: time.Now().Add(ttl)})
+	c.items[key] = el
+
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
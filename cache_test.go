@@ -0,0 +1,189 @@
+package osrswiki
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxAgeFromCacheControl(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   int
+		wantOK bool
+	}{
+		{"absent", "", 0, false},
+		{"simple", "max-age=60", 60, true},
+		{"with other directives", "public, max-age=120, must-revalidate", 120, true},
+		{"spaced", "max-age = 30", 30, true},
+		{"no-store only", "no-store", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := maxAgeFromCacheControl(tt.header)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("maxAgeFromCacheControl(%q) = (%d, %v), want (%d, %v)", tt.header, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCacheTTLPrefersHeadersOverFallback(t *testing.T) {
+	header := http.Header{}
+	header.Set("Cache-Control", "max-age=60")
+
+	if got, want := cacheTTL(header, 30*time.Second), 60*time.Second; got != want {
+		t.Errorf("cacheTTL() = %v, want %v", got, want)
+	}
+}
+
+func TestCacheTTLSubtractsAge(t *testing.T) {
+	header := http.Header{}
+	header.Set("Cache-Control", "max-age=60")
+	header.Set("Age", "50")
+
+	if got, want := cacheTTL(header, 30*time.Second), 10*time.Second; got != want {
+		t.Errorf("cacheTTL() = %v, want %v", got, want)
+	}
+}
+
+func TestCacheTTLZeroWhenAgeExceedsMaxAge(t *testing.T) {
+	header := http.Header{}
+	header.Set("Cache-Control", "max-age=60")
+	header.Set("Age", "120")
+
+	if got := cacheTTL(header, 30*time.Second); got != 0 {
+		t.Errorf("cacheTTL() = %v, want 0", got)
+	}
+}
+
+func TestCacheTTLFallsBackWithoutHeaders(t *testing.T) {
+	if got, want := cacheTTL(http.Header{}, 30*time.Second), 30*time.Second; got != want {
+		t.Errorf("cacheTTL() = %v, want %v", got, want)
+	}
+}
+
+func TestLRUCacheGetSetAndExpiry(t *testing.T) {
+	c := newLRUCache(2)
+
+	c.Set("a", []byte("1"), time.Minute)
+	if got, ok := c.Get("a"); !ok || string(got) != "1" {
+		t.Fatalf("Get(a) = (%q, %v), want (\"1\", true)", got, ok)
+	}
+
+	c.Set("expired", []byte("x"), -time.Second)
+	if _, ok := c.Get("expired"); ok {
+		t.Error("Get(expired) ok = true, want false")
+	}
+}
+
+func TestLRUCacheEvictsOldest(t *testing.T) {
+	c := newLRUCache(2)
+
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+	c.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a) ok = true after eviction, want false")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("Get(b) ok = false, want true")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Error("Get(c) ok = false, want true")
+	}
+}
+
+func TestItemMappingServeFromCacheWithinTTL(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":4151,"name":"Abyssal whip","limit":70}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-agent", WithBaseURL(server.URL))
+
+	for i := 0; i < 3; i++ {
+		items, err := c.NewItemMappingService().World(WorldRegular).Do(context.Background())
+		if err != nil {
+			t.Fatalf("Do() error = %v, want nil", err)
+		}
+		if len(items) != 1 || items[0].Name != "Abyssal whip" {
+			t.Fatalf("Do() = %+v, want a single Abyssal whip entry", items)
+		}
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("server received %d calls, want 1 (later calls should be served from cache)", got)
+	}
+}
+
+func TestItemMappingRefreshBypassesCache(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":4151,"name":"Abyssal whip","limit":70}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-agent", WithBaseURL(server.URL))
+	svc := c.NewItemMappingService().World(WorldRegular)
+
+	if _, err := svc.Do(context.Background()); err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	if _, err := svc.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v, want nil", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("server received %d calls, want 2 (Refresh must bypass the cache)", got)
+	}
+}
+
+func TestLatestPricesCacheRespectsUpstreamMaxAge(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "max-age=0")
+		w.Write([]byte(`{"data":{"4151":{"high":100,"highTime":1,"low":90,"lowTime":1}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-agent", WithBaseURL(server.URL))
+
+	if _, err := c.NewLatestPricesService().World(WorldRegular).ItemIDs(4151).Do(context.Background()); err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	if _, err := c.NewLatestPricesService().World(WorldRegular).ItemIDs(4151).Do(context.Background()); err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("server received %d calls, want 2 (max-age=0 should disable caching for this response)", got)
+	}
+}
+
+func TestCacheKeyIsDeterministic(t *testing.T) {
+	query := map[string]string{"b": "2", "a": "1"}
+
+	key1 := cacheKey("http://example.com/x", query)
+	key2 := cacheKey("http://example.com/x", query)
+	if key1 != key2 {
+		t.Errorf("cacheKey() not deterministic: %q != %q", key1, key2)
+	}
+
+	if got := cacheKey("http://example.com/x", nil); got != "http://example.com/x" {
+		t.Errorf("cacheKey() with no query = %q, want bare URL", got)
+	}
+}
@@ -2,12 +2,9 @@ package osrswiki
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -18,12 +15,49 @@ const (
 type Client struct {
 	userAgent  string
 	httpClient *http.Client
+	retry      retryConfig
+	cache      Cache
+	baseURL    string
 }
 
-func NewClient(userAgent string) *Client {
-	return &Client{
+// ClientOption customizes a Client created with NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL overrides the prices API's base URL, e.g. to point the
+// Client at a mirror or, in tests, an httptest server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+func NewClient(userAgent string, opts ...ClientOption) *Client {
+	c := &Client{
 		userAgent:  userAgent,
 		httpClient: &http.Client{},
+		retry:      defaultRetryConfig,
+		cache:      newLRUCache(defaultCacheCapacity),
+		baseURL:    pricesEndpoint,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// RequestOption customizes an individual request made by a service's Do
+// method, layered on top of the Client's own defaults. It can be used to
+// override the User-Agent for that one call or to inject request-scoped
+// headers such as tracing IDs.
+type RequestOption func(*http.Request)
+
+// WithHeader sets header to value on the outgoing request, overriding any
+// value the Client would otherwise set.
+func WithHeader(header, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(header, value)
 	}
 }
 
@@ -42,42 +76,6 @@ type LatestPrice struct {
 	LowTime  int64 `json:"lowTime"`
 }
 
-func (c *Client) LatestPrices(ctx context.Context, world World, itemIDs ...int16) (map[int16]LatestPrice, error) {
-	url := fmt.Sprintf("%s/%s/latest", pricesEndpoint, world)
-
-	query := make(map[string]string)
-	if len(itemIDs) > 0 {
-		ids := make([]string, len(itemIDs))
-		for i, id := range itemIDs {
-			ids[i] = strconv.FormatInt(int64(id), 10)
-		}
-		query["id"] = strings.Join(ids, ",")
-	}
-
-	body, err := c.doRequest(ctx, url, query)
-	if err != nil {
-		return nil, err
-	}
-
-	var response struct {
-		Data map[string]LatestPrice `json:"data"`
-	}
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	result := make(map[int16]LatestPrice)
-	for itemIDStr, data := range response.Data {
-		itemID, err := strconv.ParseInt(itemIDStr, 10, 16)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing item ID: %w", err)
-		}
-		result[int16(itemID)] = data
-	}
-	return result, nil
-}
-
 type ItemMapping struct {
 	ID       int    `json:"id"`
 	Icon     string `json:"icon"`
@@ -90,23 +88,6 @@ type ItemMapping struct {
 	Limit    int    `json:"limit"`
 }
 
-func (c *Client) ItemMapping(ctx context.Context, world World) ([]ItemMapping, error) {
-	url := fmt.Sprintf("%s/%s/%s", pricesEndpoint, world, "mapping")
-
-	body, err := c.doRequest(ctx, url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var items []ItemMapping
-	err = json.Unmarshal(body, &items)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return items, nil
-}
-
 type TimeInterval string
 
 const (
@@ -123,42 +104,6 @@ type PriceData struct {
 	LowPriceVolume  int64 `json:"lowPriceVolume"`
 }
 
-func (c *Client) PriceData(ctx context.Context, world World, interval TimeInterval, timestamp *time.Time) (map[int16]PriceData, error) {
-	if interval != FiveMinutes && interval != OneHour {
-		return nil, fmt.Errorf("only 5m and 1h intervals are supported")
-	}
-
-	url := fmt.Sprintf("%s/%s/%s", pricesEndpoint, world, interval)
-
-	query := make(map[string]string)
-	if timestamp != nil {
-		query["timestamp"] = fmt.Sprintf("%d", timestamp.Unix())
-	}
-
-	body, err := c.doRequest(ctx, url, query)
-	if err != nil {
-		return nil, err
-	}
-
-	var response struct {
-		Data map[string]PriceData `json:"data"`
-	}
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	result := make(map[int16]PriceData)
-	for itemIDStr, data := range response.Data {
-		itemID, err := strconv.ParseInt(itemIDStr, 10, 16)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing item ID: %w", err)
-		}
-		result[int16(itemID)] = data
-	}
-	return result, nil
-}
-
 type TimeseriesData struct {
 	Timestamp       int64 `json:"timestamp"`
 	AvgHighPrice    int64 `json:"avgHighPrice"`
@@ -167,34 +112,64 @@ type TimeseriesData struct {
 	LowPriceVolume  int64 `json:"lowPriceVolume"`
 }
 
-func (c *Client) Timeseries(ctx context.Context, world World, timestep TimeInterval, itemID int16) ([]TimeseriesData, error) {
-	url := fmt.Sprintf("%s/%s/timeseries", pricesEndpoint, world)
-
-	query := map[string]string{
-		"id":       fmt.Sprintf("%d", itemID),
-		"timestep": string(timestep),
+// doRequest issues a GET request to url with the given query parameters,
+// retrying transient network errors and 429/5xx responses according to
+// c.retry. It returns as soon as ctx is canceled, and wraps the final
+// error in a *RetryError once all attempts are exhausted.
+//
+// ttl enables caching of a successful response under the request's
+// canonical URL: if refresh is false and a fresh entry exists, it's
+// returned without hitting the network; otherwise the fetched body is
+// stored for ttl (or for the upstream's own Cache-Control max-age, if
+// present). A ttl of 0 disables caching for this request.
+func (c *Client) doRequest(ctx context.Context, url string, query map[string]string, ttl time.Duration, refresh bool, opts ...RequestOption) ([]byte, error) {
+	key := cacheKey(url, query)
+	if !refresh && ttl > 0 && c.cache != nil {
+		if body, ok := c.cache.Get(key); ok {
+			return body, nil
+		}
 	}
 
-	body, err := c.doRequest(ctx, url, query)
-	if err != nil {
-		return nil, err
+	maxAttempts := c.retry.maxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
 	}
 
-	var response struct {
-		Data []TimeseriesData `json:"data"`
-	}
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		body, header, retryable, retryAfter, err := c.doRequestOnce(ctx, url, query, opts...)
+		if err == nil {
+			if ttl > 0 && c.cache != nil {
+				c.cache.Set(key, body, cacheTTL(header, ttl))
+			}
+			return body, nil
+		}
+		if !isRetryableError(err) {
+			return nil, err
+		}
+		if !retryable {
+			return nil, err
+		}
+		lastErr = err
+
+		if attempt == maxAttempts-1 {
+			break
+		}
+		if err := sleepWithContext(ctx, retryDelay(c.retry, attempt, retryAfter)); err != nil {
+			return nil, err
+		}
 	}
 
-	return response.Data, nil
+	return nil, &RetryError{Attempts: maxAttempts, Err: lastErr}
 }
 
-func (c *Client) doRequest(ctx context.Context, url string, query map[string]string) ([]byte, error) {
+// doRequestOnce performs a single HTTP round trip. retryable reports
+// whether err (if any) is worth retrying, and retryAfter carries the
+// upstream's requested backoff when it sent one.
+func (c *Client) doRequestOnce(ctx context.Context, url string, query map[string]string, opts ...RequestOption) (body []byte, header http.Header, retryable bool, retryAfter time.Duration, err error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+		return nil, nil, false, 0, fmt.Errorf("creating request: %w", err)
 	}
 
 	req.Header.Set("User-Agent", c.userAgent)
@@ -205,20 +180,25 @@ func (c *Client) doRequest(ctx context.Context, url string, query map[string]str
 	}
 	req.URL.RawQuery = q.Encode()
 
+	for _, opt := range opts {
+		opt(req)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("sending request: %w", err)
+		return nil, nil, true, 0, fmt.Errorf("sending request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		return nil, nil, isRetryableStatus(resp.StatusCode), retryAfter, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
+		return nil, nil, false, 0, fmt.Errorf("reading response body: %w", err)
 	}
 
-	return body, nil
+	return respBody, resp.Header, false, 0, nil
 }
@@ -0,0 +1,76 @@
+package osrswiki
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// The methods in this file are deprecated shims preserving the pre-v2,
+// int16-keyed API that existed before item IDs were widened to int32 (OSRS
+// item IDs now routinely exceed math.MaxInt16). They delegate to the
+// current *Service builders and exist only so callers can keep compiling
+// while they migrate; new code should call the service builders directly.
+
+// LatestPrices is a deprecated alias for
+// Client.NewLatestPricesService().World(world).ItemIDs(...).Do(ctx).
+//
+// Deprecated: item IDs no longer fit in int16. Items whose ID exceeds the
+// int16 range are silently omitted from the result. Use
+// NewLatestPricesService instead.
+func (c *Client) LatestPrices(ctx context.Context, world World, itemIDs ...int16) (map[int16]LatestPrice, error) {
+	ids := make([]int32, len(itemIDs))
+	for i, id := range itemIDs {
+		ids[i] = int32(id)
+	}
+
+	prices, err := c.NewLatestPricesService().World(world).ItemIDs(ids...).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int16]LatestPrice, len(prices))
+	for id, price := range prices {
+		if id < math.MinInt16 || id > math.MaxInt16 {
+			continue
+		}
+		result[int16(id)] = price
+	}
+	return result, nil
+}
+
+// PriceData is a deprecated alias for
+// Client.NewPriceDataService().World(world).Interval(interval).Timestamp(...).Do(ctx).
+//
+// Deprecated: item IDs no longer fit in int16. Items whose ID exceeds the
+// int16 range are silently omitted from the result. Use
+// NewPriceDataService instead.
+func (c *Client) PriceData(ctx context.Context, world World, interval TimeInterval, timestamp *time.Time) (map[int16]PriceData, error) {
+	svc := c.NewPriceDataService().World(world).Interval(interval)
+	if timestamp != nil {
+		svc = svc.Timestamp(timestamp.Unix())
+	}
+
+	data, err := svc.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int16]PriceData, len(data))
+	for id, d := range data {
+		if id < math.MinInt16 || id > math.MaxInt16 {
+			continue
+		}
+		result[int16(id)] = d
+	}
+	return result, nil
+}
+
+// Timeseries is a deprecated alias for
+// Client.NewTimeseriesService().World(world).Timestep(timestep).ItemID(itemID).Do(ctx).
+//
+// Deprecated: item IDs no longer fit in int16. Use NewTimeseriesService
+// instead for items whose ID exceeds the int16 range.
+func (c *Client) Timeseries(ctx context.Context, world World, timestep TimeInterval, itemID int16) ([]TimeseriesData, error) {
+	return c.NewTimeseriesService().World(world).Timestep(timestep).ItemID(int32(itemID)).Do(ctx)
+}
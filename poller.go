@@ -0,0 +1,198 @@
+package osrswiki
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Poller periodically fetches LatestPrices for a World and fans out
+// per-item deltas to subscribers. A single Poller coalesces all of its
+// subscribers' item IDs into one upstream request per tick.
+type Poller struct {
+	client   *Client
+	world    World
+	interval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[int]*subscription
+	nextID      int
+	lastSeen    map[int32]LatestPrice
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type subscription struct {
+	itemIDs map[int32]struct{}
+	ch      chan map[int32]LatestPrice
+}
+
+// NewPoller creates a Poller that polls LatestPrices for world every
+// interval. Polling does not start until the first call to Subscribe.
+func (c *Client) NewPoller(world World, interval time.Duration) *Poller {
+	return &Poller{
+		client:      c,
+		world:       world,
+		interval:    interval,
+		subscribers: make(map[int]*subscription),
+		lastSeen:    make(map[int32]LatestPrice),
+	}
+}
+
+// Subscribe registers interest in itemIDs and returns a channel that
+// receives a map of only the items whose HighTime or LowTime advanced
+// since the previous tick. Calling the returned cancel func unsubscribes
+// and closes the channel. Subscribing starts the poller's background loop
+// if it is not already running.
+func (p *Poller) Subscribe(itemIDs ...int32) (<-chan map[int32]LatestPrice, func()) {
+	sub := &subscription{
+		itemIDs: make(map[int32]struct{}, len(itemIDs)),
+		ch:      make(chan map[int32]LatestPrice, 1),
+	}
+	for _, id := range itemIDs {
+		sub.itemIDs[id] = struct{}{}
+	}
+
+	p.mu.Lock()
+	id := p.nextID
+	p.nextID++
+	p.subscribers[id] = sub
+	started := p.cancel != nil
+	p.mu.Unlock()
+
+	if !started {
+		p.start()
+	}
+
+	cancel := func() {
+		p.mu.Lock()
+		if _, ok := p.subscribers[id]; ok {
+			delete(p.subscribers, id)
+			close(sub.ch)
+		}
+		p.mu.Unlock()
+	}
+
+	return sub.ch, cancel
+}
+
+// start launches the poller's background tick loop. Callers must hold no
+// locks when calling start.
+func (p *Poller) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p.mu.Lock()
+	if p.cancel != nil {
+		p.mu.Unlock()
+		cancel()
+		return
+	}
+	p.cancel = cancel
+	p.done = make(chan struct{})
+	p.mu.Unlock()
+
+	go p.run(ctx)
+}
+
+// Stop halts the background tick loop and unsubscribes all subscribers,
+// closing their channels. A stopped Poller can be reused: the next
+// Subscribe call restarts the tick loop.
+func (p *Poller) Stop() {
+	p.mu.Lock()
+	cancel := p.cancel
+	done := p.done
+	p.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+
+	p.mu.Lock()
+	for id, sub := range p.subscribers {
+		delete(p.subscribers, id)
+		close(sub.ch)
+	}
+	p.cancel = nil
+	p.done = nil
+	p.mu.Unlock()
+}
+
+func (p *Poller) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.tick(ctx)
+		}
+	}
+}
+
+// tick fetches LatestPrices for the union of all subscribed item IDs and
+// delivers the items that changed since the last tick to each interested
+// subscriber.
+func (p *Poller) tick(ctx context.Context) {
+	p.mu.Lock()
+	if len(p.subscribers) == 0 {
+		p.mu.Unlock()
+		return
+	}
+
+	idSet := make(map[int32]struct{})
+	for _, sub := range p.subscribers {
+		for id := range sub.itemIDs {
+			idSet[id] = struct{}{}
+		}
+	}
+	ids := make([]int32, 0, len(idSet))
+	for id := range idSet {
+		ids = append(ids, id)
+	}
+	p.mu.Unlock()
+
+	prices, err := p.client.NewLatestPricesService().World(p.world).ItemIDs(ids...).Do(ctx)
+	if err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := make(map[int32]LatestPrice)
+	for id, price := range prices {
+		prev, ok := p.lastSeen[id]
+		if !ok || price.HighTime > prev.HighTime || price.LowTime > prev.LowTime {
+			changed[id] = price
+		}
+		p.lastSeen[id] = price
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	for _, sub := range p.subscribers {
+		delta := make(map[int32]LatestPrice)
+		for id, price := range changed {
+			if _, ok := sub.itemIDs[id]; ok {
+				delta[id] = price
+			}
+		}
+		if len(delta) == 0 {
+			continue
+		}
+		select {
+		case sub.ch <- delta:
+		default:
+			// Subscriber hasn't drained the previous tick yet; drop this
+			// one rather than blocking the poller loop.
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package osrswiki
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryConfig controls the backoff behavior applied to idempotent GET
+// requests made by doRequest.
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+// defaultRetryConfig is applied to every Client unless overridden with
+// WithRetry, so the four public endpoints retry transient failures out of
+// the box.
+var defaultRetryConfig = retryConfig{
+	maxAttempts: 3,
+	baseDelay:   200 * time.Millisecond,
+	maxDelay:    5 * time.Second,
+}
+
+// WithRetry configures the retry/backoff behavior used for transient
+// failures (network errors and 429/5xx responses). maxAttempts is the total
+// number of attempts, including the first one; set it to 1 to disable
+// retries. Delay between attempts grows exponentially from baseDelay up to
+// maxDelay, with full jitter applied on top.
+func WithRetry(maxAttempts int, baseDelay, maxDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retry = retryConfig{
+			maxAttempts: maxAttempts,
+			baseDelay:   baseDelay,
+			maxDelay:    maxDelay,
+		}
+	}
+}
+
+// RetryError is returned when doRequest gives up after exhausting all
+// configured attempts. Callers can use errors.As to distinguish this case
+// from a request that failed because ctx was canceled or timed out, which
+// is surfaced as the context's own error instead.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("request failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// isRetryableStatus reports whether a response status code represents a
+// transient failure worth retrying.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay computes the sleep duration after the given failed attempt
+// (0-based), before the next one is made, using exponential backoff with full jitter, honoring a Retry-After header
+// when the upstream provided one.
+func retryDelay(cfg retryConfig, attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > cfg.maxDelay {
+			return cfg.maxDelay
+		}
+		return retryAfter
+	}
+
+	backoff := cfg.baseDelay * time.Duration(1<<uint(attempt))
+	if backoff <= 0 || backoff > cfg.maxDelay {
+		backoff = cfg.maxDelay
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// parseRetryAfter parses a Retry-After header, which may be either a number
+// of seconds or an HTTP date. It returns 0 if the header is absent or
+// unparsable.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
+
+// sleepWithContext waits for d, returning early with ctx.Err() if ctx is
+// canceled first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isRetryableError reports whether err represents a transient transport
+// failure worth retrying, as opposed to ctx being canceled.
+func isRetryableError(err error) bool {
+	return err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,136 @@
+package osrswiki
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	cfg := retryConfig{baseDelay: 100 * time.Millisecond, maxDelay: 5 * time.Second}
+
+	if got := retryDelay(cfg, 0, 2*time.Second); got != 2*time.Second {
+		t.Errorf("retryDelay() = %v, want %v", got, 2*time.Second)
+	}
+
+	if got := retryDelay(cfg, 0, 10*time.Second); got != cfg.maxDelay {
+		t.Errorf("retryDelay() with retryAfter > maxDelay = %v, want %v (capped)", got, cfg.maxDelay)
+	}
+}
+
+func TestRetryDelayBackoffIsBoundedAndJittered(t *testing.T) {
+	cfg := retryConfig{baseDelay: 100 * time.Millisecond, maxDelay: 2 * time.Second}
+
+	for attempt := 0; attempt < 6; attempt++ {
+		want := cfg.baseDelay * time.Duration(1<<uint(attempt))
+		if want > cfg.maxDelay {
+			want = cfg.maxDelay
+		}
+
+		for i := 0; i < 20; i++ {
+			got := retryDelay(cfg, attempt, 0)
+			if got < 0 || got > want {
+				t.Fatalf("retryDelay(attempt=%d) = %v, want within [0, %v]", attempt, got, want)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{"empty", "", 0},
+		{"seconds", "120", 120 * time.Second},
+		{"invalid", "not-a-duration", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.header); got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterHTTPDate(t *testing.T) {
+	future := time.Now().Add(90 * time.Second).UTC()
+	got := parseRetryAfter(future.Format(http.TimeFormat))
+
+	if got <= 0 || got > 91*time.Second {
+		t.Errorf("parseRetryAfter(HTTP date) = %v, want roughly 90s", got)
+	}
+}
+
+func TestDoRequestRetriesTransparentlyOnServerError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"4151":{"high":100,"highTime":1,"low":90,"lowTime":1}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("test-agent",
+		WithBaseURL(server.URL),
+		WithRetry(5, time.Millisecond, 10*time.Millisecond),
+	)
+
+	prices, err := c.NewLatestPricesService().World(WorldRegular).ItemIDs(4151).Do(context.Background())
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	if got := prices[4151].High; got != 100 {
+		t.Errorf("prices[4151].High = %d, want 100", got)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("server received %d calls, want 3 (2 failures + 1 success)", got)
+	}
+}
+
+func TestDoRequestExhaustsRetriesOnPersistentServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient("test-agent",
+		WithBaseURL(server.URL),
+		WithRetry(3, time.Millisecond, 5*time.Millisecond),
+	)
+
+	_, err := c.NewLatestPricesService().World(WorldRegular).ItemIDs(4151).Do(context.Background())
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("Do() error = %v, want *RetryError", err)
+	}
+	if retryErr.Attempts != 3 {
+		t.Errorf("RetryError.Attempts = %d, want 3", retryErr.Attempts)
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	if isRetryableError(nil) {
+		t.Error("isRetryableError(nil) = true, want false")
+	}
+	if isRetryableError(context.Canceled) {
+		t.Error("isRetryableError(context.Canceled) = true, want false")
+	}
+	if isRetryableError(context.DeadlineExceeded) {
+		t.Error("isRetryableError(context.DeadlineExceeded) = true, want false")
+	}
+	if !isRetryableError(errors.New("connection reset")) {
+		t.Error("isRetryableError(generic error) = false, want true")
+	}
+}
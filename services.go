@@ -0,0 +1,252 @@
+package osrswiki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// itemMappingCacheTTL is long because the mapping list is mostly
+	// static; most callers only need to fetch it once per process.
+	itemMappingCacheTTL = 24 * time.Hour
+	// latestPricesCacheTTL matches the wiki's own ~60s update window, so
+	// repeated calls within that window are served from cache.
+	latestPricesCacheTTL = 60 * time.Second
+	// priceDataCacheTTL is shorter than the 5m/1h averaging windows
+	// themselves, just enough to dedupe bursts of identical calls.
+	priceDataCacheTTL = 30 * time.Second
+)
+
+// LatestPricesService builds a request to the /latest endpoint.
+type LatestPricesService struct {
+	c       *Client
+	world   World
+	itemIDs []int32
+}
+
+// NewLatestPricesService starts a new LatestPricesService.
+func (c *Client) NewLatestPricesService() *LatestPricesService {
+	return &LatestPricesService{c: c}
+}
+
+// World sets which game world to query.
+func (s *LatestPricesService) World(world World) *LatestPricesService {
+	s.world = world
+	return s
+}
+
+// ItemIDs restricts the response to the given items. If omitted, the
+// endpoint returns prices for every item.
+func (s *LatestPricesService) ItemIDs(itemIDs ...int32) *LatestPricesService {
+	s.itemIDs = itemIDs
+	return s
+}
+
+// Do executes the request and returns the latest price per item ID.
+func (s *LatestPricesService) Do(ctx context.Context, opts ...RequestOption) (map[int32]LatestPrice, error) {
+	url := fmt.Sprintf("%s/%s/latest", s.c.baseURL, s.world)
+
+	query := make(map[string]string)
+	if len(s.itemIDs) > 0 {
+		ids := make([]string, len(s.itemIDs))
+		for i, id := range s.itemIDs {
+			ids[i] = strconv.FormatInt(int64(id), 10)
+		}
+		query["id"] = strings.Join(ids, ",")
+	}
+
+	body, err := s.c.doRequest(ctx, url, query, latestPricesCacheTTL, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data map[string]LatestPrice `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	result := make(map[int32]LatestPrice)
+	for itemIDStr, data := range response.Data {
+		itemID, err := strconv.ParseInt(itemIDStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing item ID: %w", err)
+		}
+		result[int32(itemID)] = data
+	}
+	return result, nil
+}
+
+// ItemMappingService builds a request to the /mapping endpoint.
+type ItemMappingService struct {
+	c     *Client
+	world World
+}
+
+// NewItemMappingService starts a new ItemMappingService.
+func (c *Client) NewItemMappingService() *ItemMappingService {
+	return &ItemMappingService{c: c}
+}
+
+// World sets which game world to query.
+func (s *ItemMappingService) World(world World) *ItemMappingService {
+	s.world = world
+	return s
+}
+
+// Do executes the request and returns the full item mapping, served from
+// cache when a fresh entry exists.
+func (s *ItemMappingService) Do(ctx context.Context, opts ...RequestOption) ([]ItemMapping, error) {
+	return s.do(ctx, false, opts...)
+}
+
+// Refresh bypasses any cached item mapping, always fetching a fresh copy
+// from the upstream and repopulating the cache with it.
+func (s *ItemMappingService) Refresh(ctx context.Context, opts ...RequestOption) ([]ItemMapping, error) {
+	return s.do(ctx, true, opts...)
+}
+
+func (s *ItemMappingService) do(ctx context.Context, refresh bool, opts ...RequestOption) ([]ItemMapping, error) {
+	url := fmt.Sprintf("%s/%s/%s", s.c.baseURL, s.world, "mapping")
+
+	body, err := s.c.doRequest(ctx, url, nil, itemMappingCacheTTL, refresh, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ItemMapping
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return items, nil
+}
+
+// PriceDataService builds a request to the /5m or /1h endpoint.
+type PriceDataService struct {
+	c         *Client
+	world     World
+	interval  TimeInterval
+	timestamp *int64
+}
+
+// NewPriceDataService starts a new PriceDataService.
+func (c *Client) NewPriceDataService() *PriceDataService {
+	return &PriceDataService{c: c}
+}
+
+// World sets which game world to query.
+func (s *PriceDataService) World(world World) *PriceDataService {
+	s.world = world
+	return s
+}
+
+// Interval selects the averaging window. Only FiveMinutes and OneHour are
+// supported by the upstream API.
+func (s *PriceDataService) Interval(interval TimeInterval) *PriceDataService {
+	s.interval = interval
+	return s
+}
+
+// Timestamp requests the window starting at the given Unix timestamp
+// instead of the most recent one.
+func (s *PriceDataService) Timestamp(timestamp int64) *PriceDataService {
+	s.timestamp = &timestamp
+	return s
+}
+
+// Do executes the request and returns price data per item ID.
+func (s *PriceDataService) Do(ctx context.Context, opts ...RequestOption) (map[int32]PriceData, error) {
+	if s.interval != FiveMinutes && s.interval != OneHour {
+		return nil, fmt.Errorf("only 5m and 1h intervals are supported")
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", s.c.baseURL, s.world, s.interval)
+
+	query := make(map[string]string)
+	if s.timestamp != nil {
+		query["timestamp"] = strconv.FormatInt(*s.timestamp, 10)
+	}
+
+	body, err := s.c.doRequest(ctx, url, query, priceDataCacheTTL, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data map[string]PriceData `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	result := make(map[int32]PriceData)
+	for itemIDStr, data := range response.Data {
+		itemID, err := strconv.ParseInt(itemIDStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing item ID: %w", err)
+		}
+		result[int32(itemID)] = data
+	}
+	return result, nil
+}
+
+// TimeseriesService builds a request to the /timeseries endpoint.
+type TimeseriesService struct {
+	c        *Client
+	world    World
+	timestep TimeInterval
+	itemID   int32
+}
+
+// NewTimeseriesService starts a new TimeseriesService.
+func (c *Client) NewTimeseriesService() *TimeseriesService {
+	return &TimeseriesService{c: c}
+}
+
+// World sets which game world to query.
+func (s *TimeseriesService) World(world World) *TimeseriesService {
+	s.world = world
+	return s
+}
+
+// Timestep selects the granularity of the returned series.
+func (s *TimeseriesService) Timestep(timestep TimeInterval) *TimeseriesService {
+	s.timestep = timestep
+	return s
+}
+
+// ItemID selects which item to fetch a series for.
+func (s *TimeseriesService) ItemID(itemID int32) *TimeseriesService {
+	s.itemID = itemID
+	return s
+}
+
+// Do executes the request and returns the series, oldest point first.
+func (s *TimeseriesService) Do(ctx context.Context, opts ...RequestOption) ([]TimeseriesData, error) {
+	url := fmt.Sprintf("%s/%s/timeseries", s.c.baseURL, s.world)
+
+	query := map[string]string{
+		"id":       strconv.FormatInt(int64(s.itemID), 10),
+		"timestep": string(s.timestep),
+	}
+
+	body, err := s.c.doRequest(ctx, url, query, 0, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []TimeseriesData `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return response.Data, nil
+}